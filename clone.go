@@ -0,0 +1,23 @@
+package optional
+
+// Cloner is implemented by value types that know how to produce a deep copy of
+// themselves. Option[T].Clone uses it when T implements Cloner[T], and falls
+// back to a shallow copy otherwise.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// Clone returns a copy of the receiver. If the receiver is Some and T implements
+// Cloner[T], the contained value is deep-copied via its Clone method. Otherwise,
+// the receiver is returned as-is (a shallow copy).
+func (o Option[T]) Clone() Option[T] {
+	if !o.IsSome() {
+		return o
+	}
+
+	if cloner, ok := interface{}(o.value).(Cloner[T]); ok {
+		return Some(cloner.Clone())
+	}
+
+	return o
+}
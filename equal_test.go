@@ -0,0 +1,53 @@
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional"
+)
+
+func TestEqual(t *testing.T) {
+	assert.True(t, optional.Equal(optional.Some(2), optional.Some(2)))
+	assert.False(t, optional.Equal(optional.Some(2), optional.Some(3)))
+	assert.True(t, optional.Equal(optional.None[int](), optional.None[int]()))
+	assert.True(t, optional.Equal(optional.JsonNull[int](), optional.JsonNull[int]()))
+	assert.False(t, optional.Equal(optional.None[int](), optional.JsonNull[int]()))
+	assert.False(t, optional.Equal(optional.Some(2), optional.None[int]()))
+}
+
+// cloneableValue's Clone deep-copies xs into a new backing array, so a test
+// asserting on it can tell a real Clone() call apart from a plain shallow
+// copy: mutating the original's slice after cloning would otherwise leak
+// through to the clone.
+type cloneableValue struct {
+	v  int
+	xs []int
+}
+
+func (c cloneableValue) Clone() cloneableValue {
+	xs := make([]int, len(c.xs))
+	copy(xs, c.xs)
+	return cloneableValue{v: c.v, xs: xs}
+}
+
+func TestClone(t *testing.T) {
+	t.Run("uses Cloner when implemented", func(t *testing.T) {
+		o := optional.Some(cloneableValue{v: 1, xs: []int{1, 2, 3}})
+		cloned := o.Clone()
+		assert.Equal(t, o, cloned)
+
+		o.Unwrap().xs[0] = 99
+		assert.Equal(t, 1, cloned.Unwrap().xs[0])
+	})
+
+	t.Run("shallow copies otherwise", func(t *testing.T) {
+		o := optional.Some(2)
+		assert.Equal(t, o, o.Clone())
+	})
+
+	t.Run("None and JsonNull pass through", func(t *testing.T) {
+		assert.True(t, optional.None[int]().Clone().IsNone())
+		assert.True(t, optional.JsonNull[int]().Clone().IsJsonNull())
+	})
+}
@@ -0,0 +1,125 @@
+// Package easyjson bridges jsonoptional.Option[T] into the mailru/easyjson fast
+// path, for services that generate MarshalEasyJSON/UnmarshalEasyJSON methods on
+// their DTOs and want to avoid the reflection encoding/json otherwise falls back
+// to for Option fields.
+//
+// Option[T] can't implement easyjson.Marshaler/Unmarshaler itself: those
+// interfaces require methods on the concrete field type, and Go doesn't allow a
+// subpackage to attach methods to a generic type declared elsewhere. Instead,
+// this package exposes the write/read primitives that a generated (or
+// hand-written) MarshalEasyJSON/UnmarshalEasyJSON method can call for an
+// Option[T]-typed field, mirroring the per-type optional templates in
+// mailru/easyjson/opt.
+package easyjson
+
+import (
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/tapp-ai/json-go-optional/jsonoptional"
+)
+
+// MarshalEasyJSONFunc writes o to w, using writeValue to encode the contained value.
+// Null is written as the JSON literal null; None falls back to writing the zero value
+// of T, matching jsonoptional.Option[T]'s own MarshalJSON behavior when marshaled
+// outside of an omitempty field. This is the generator hook for user-defined T; common
+// types should use the Marshal<Type> helpers below instead.
+func MarshalEasyJSONFunc[T any](o jsonoptional.Option[T], w *jwriter.Writer, writeValue func(*jwriter.Writer, T)) {
+	if o.IsNull() {
+		w.RawString("null")
+		return
+	}
+
+	writeValue(w, o.Unwrap())
+}
+
+// UnmarshalEasyJSONFunc reads an Option[T] from l, using readValue to decode a present
+// value. The caller is expected to only invoke this when the field was present in the
+// input, matching the "undefined field never calls Unmarshal" semantics of
+// jsonoptional.Option[T]'s UnmarshalJSON. This is the generator hook for user-defined T;
+// common types should use the Unmarshal<Type> helpers below instead.
+func UnmarshalEasyJSONFunc[T any](l *jlexer.Lexer, readValue func(*jlexer.Lexer) T) jsonoptional.Option[T] {
+	if l.IsNull() {
+		l.Skip()
+		return jsonoptional.Null[T]()
+	}
+
+	return jsonoptional.Some(readValue(l))
+}
+
+// MarshalEasyJSONString writes o to w.
+func MarshalEasyJSONString(o jsonoptional.Option[string], w *jwriter.Writer) {
+	MarshalEasyJSONFunc(o, w, (*jwriter.Writer).String)
+}
+
+// UnmarshalEasyJSONString reads an Option[string] from l.
+func UnmarshalEasyJSONString(l *jlexer.Lexer) jsonoptional.Option[string] {
+	return UnmarshalEasyJSONFunc(l, (*jlexer.Lexer).String)
+}
+
+// MarshalEasyJSONInt writes o to w.
+func MarshalEasyJSONInt(o jsonoptional.Option[int], w *jwriter.Writer) {
+	MarshalEasyJSONFunc(o, w, (*jwriter.Writer).Int)
+}
+
+// UnmarshalEasyJSONInt reads an Option[int] from l.
+func UnmarshalEasyJSONInt(l *jlexer.Lexer) jsonoptional.Option[int] {
+	return UnmarshalEasyJSONFunc(l, (*jlexer.Lexer).Int)
+}
+
+// MarshalEasyJSONInt64 writes o to w.
+func MarshalEasyJSONInt64(o jsonoptional.Option[int64], w *jwriter.Writer) {
+	MarshalEasyJSONFunc(o, w, (*jwriter.Writer).Int64)
+}
+
+// UnmarshalEasyJSONInt64 reads an Option[int64] from l.
+func UnmarshalEasyJSONInt64(l *jlexer.Lexer) jsonoptional.Option[int64] {
+	return UnmarshalEasyJSONFunc(l, (*jlexer.Lexer).Int64)
+}
+
+// MarshalEasyJSONFloat64 writes o to w.
+func MarshalEasyJSONFloat64(o jsonoptional.Option[float64], w *jwriter.Writer) {
+	MarshalEasyJSONFunc(o, w, (*jwriter.Writer).Float64)
+}
+
+// UnmarshalEasyJSONFloat64 reads an Option[float64] from l.
+func UnmarshalEasyJSONFloat64(l *jlexer.Lexer) jsonoptional.Option[float64] {
+	return UnmarshalEasyJSONFunc(l, (*jlexer.Lexer).Float64)
+}
+
+// MarshalEasyJSONBool writes o to w.
+func MarshalEasyJSONBool(o jsonoptional.Option[bool], w *jwriter.Writer) {
+	MarshalEasyJSONFunc(o, w, (*jwriter.Writer).Bool)
+}
+
+// UnmarshalEasyJSONBool reads an Option[bool] from l.
+func UnmarshalEasyJSONBool(l *jlexer.Lexer) jsonoptional.Option[bool] {
+	return UnmarshalEasyJSONFunc(l, (*jlexer.Lexer).Bool)
+}
+
+// MarshalEasyJSONTime writes o to w, encoding a present value the same way
+// time.Time.MarshalJSON does (RFC 3339 with nanoseconds).
+func MarshalEasyJSONTime(o jsonoptional.Option[time.Time], w *jwriter.Writer) {
+	MarshalEasyJSONFunc(o, w, writeTime)
+}
+
+// UnmarshalEasyJSONTime reads an Option[time.Time] from l, decoding a present value
+// the same way time.Time.UnmarshalJSON does (RFC 3339 with nanoseconds).
+func UnmarshalEasyJSONTime(l *jlexer.Lexer) jsonoptional.Option[time.Time] {
+	return UnmarshalEasyJSONFunc(l, readTime)
+}
+
+func writeTime(w *jwriter.Writer, t time.Time) {
+	w.Raw(t.MarshalJSON())
+}
+
+func readTime(l *jlexer.Lexer) time.Time {
+	s := l.String()
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		l.AddError(err)
+		return time.Time{}
+	}
+	return t
+}
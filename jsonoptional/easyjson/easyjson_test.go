@@ -0,0 +1,141 @@
+package easyjson_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional/jsonoptional"
+	jsonoptionaleasyjson "github.com/tapp-ai/json-go-optional/jsonoptional/easyjson"
+)
+
+func TestMarshalEasyJSONString(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		w := &jwriter.Writer{}
+		jsonoptionaleasyjson.MarshalEasyJSONString(jsonoptional.Some("hello"), w)
+		data, err := w.BuildBytes()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `"hello"`, string(data))
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		w := &jwriter.Writer{}
+		jsonoptionaleasyjson.MarshalEasyJSONString(jsonoptional.Null[string](), w)
+		data, err := w.BuildBytes()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `null`, string(data))
+	})
+}
+
+func TestUnmarshalEasyJSONString(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		l := &jlexer.Lexer{Data: []byte(`"hello"`)}
+		o := jsonoptionaleasyjson.UnmarshalEasyJSONString(l)
+		assert.NoError(t, l.Error())
+		assert.True(t, o.IsSome())
+		assert.Equal(t, "hello", o.Unwrap())
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		l := &jlexer.Lexer{Data: []byte(`null`)}
+		o := jsonoptionaleasyjson.UnmarshalEasyJSONString(l)
+		assert.NoError(t, l.Error())
+		assert.True(t, o.IsNull())
+	})
+}
+
+func TestMarshalEasyJSONTime(t *testing.T) {
+	value := time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC)
+
+	w := &jwriter.Writer{}
+	jsonoptionaleasyjson.MarshalEasyJSONTime(jsonoptional.Some(value), w)
+	data, err := w.BuildBytes()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `"2024-09-13T00:00:00Z"`, string(data))
+
+	l := &jlexer.Lexer{Data: data}
+	o := jsonoptionaleasyjson.UnmarshalEasyJSONTime(l)
+	assert.NoError(t, l.Error())
+	assert.True(t, o.IsSome())
+	assert.True(t, value.Equal(o.Unwrap()))
+}
+
+func TestUnmarshalEasyJSONTimeMalformed(t *testing.T) {
+	l := &jlexer.Lexer{Data: []byte(`"not-a-time"`)}
+	o := jsonoptionaleasyjson.UnmarshalEasyJSONTime(l)
+	assert.Error(t, l.Error())
+	assert.True(t, o.IsSome())
+	assert.True(t, o.Unwrap().IsZero())
+}
+
+func TestMarshalEasyJSONInt(t *testing.T) {
+	w := &jwriter.Writer{}
+	jsonoptionaleasyjson.MarshalEasyJSONInt(jsonoptional.Some(7), w)
+	data, err := w.BuildBytes()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `7`, string(data))
+
+	l := &jlexer.Lexer{Data: []byte(`7`)}
+	o := jsonoptionaleasyjson.UnmarshalEasyJSONInt(l)
+	assert.NoError(t, l.Error())
+	assert.True(t, o.IsSome())
+	assert.Equal(t, 7, o.Unwrap())
+}
+
+func TestMarshalEasyJSONIntNone(t *testing.T) {
+	w := &jwriter.Writer{}
+	jsonoptionaleasyjson.MarshalEasyJSONInt(jsonoptional.None[int](), w)
+	data, err := w.BuildBytes()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `0`, string(data))
+}
+
+func TestMarshalEasyJSONInt64(t *testing.T) {
+	w := &jwriter.Writer{}
+	jsonoptionaleasyjson.MarshalEasyJSONInt64(jsonoptional.Some(int64(42)), w)
+	data, err := w.BuildBytes()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `42`, string(data))
+
+	l := &jlexer.Lexer{Data: []byte(`42`)}
+	o := jsonoptionaleasyjson.UnmarshalEasyJSONInt64(l)
+	assert.NoError(t, l.Error())
+	assert.True(t, o.IsSome())
+	assert.Equal(t, int64(42), o.Unwrap())
+}
+
+func TestMarshalEasyJSONFloat64(t *testing.T) {
+	w := &jwriter.Writer{}
+	jsonoptionaleasyjson.MarshalEasyJSONFloat64(jsonoptional.Some(3.14), w)
+	data, err := w.BuildBytes()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `3.14`, string(data))
+
+	l := &jlexer.Lexer{Data: []byte(`3.14`)}
+	o := jsonoptionaleasyjson.UnmarshalEasyJSONFloat64(l)
+	assert.NoError(t, l.Error())
+	assert.True(t, o.IsSome())
+	assert.Equal(t, 3.14, o.Unwrap())
+}
+
+func TestMarshalEasyJSONBool(t *testing.T) {
+	w := &jwriter.Writer{}
+	jsonoptionaleasyjson.MarshalEasyJSONBool(jsonoptional.Some(true), w)
+	data, err := w.BuildBytes()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `true`, string(data))
+
+	l := &jlexer.Lexer{Data: []byte(`true`)}
+	o := jsonoptionaleasyjson.UnmarshalEasyJSONBool(l)
+	assert.NoError(t, l.Error())
+	assert.True(t, o.IsSome())
+	assert.True(t, o.Unwrap())
+
+	w = &jwriter.Writer{}
+	jsonoptionaleasyjson.MarshalEasyJSONBool(jsonoptional.Null[bool](), w)
+	data, err = w.BuildBytes()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `null`, string(data))
+}
@@ -0,0 +1,21 @@
+package jsonoptional
+
+// Equal reports whether a and b are in the same state (Some/None/Null) and,
+// when both are Some, hold equal values.
+func Equal[T comparable](a, b Option[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualFunc reports whether a and b are in the same state (Some/None/Null) and,
+// when both are Some, satisfy eq.
+func EqualFunc[T any](a, b Option[T], eq func(x, y T) bool) bool {
+	if a.IsSome() != b.IsSome() || a.IsNull() != b.IsNull() {
+		return false
+	}
+
+	if !a.IsSome() {
+		return true
+	}
+
+	return eq(a.Unwrap(), b.Unwrap())
+}
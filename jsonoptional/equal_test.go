@@ -0,0 +1,53 @@
+package jsonoptional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional/jsonoptional"
+)
+
+func TestEqual(t *testing.T) {
+	assert.True(t, jsonoptional.Equal(jsonoptional.Some(2), jsonoptional.Some(2)))
+	assert.False(t, jsonoptional.Equal(jsonoptional.Some(2), jsonoptional.Some(3)))
+	assert.True(t, jsonoptional.Equal(jsonoptional.None[int](), jsonoptional.None[int]()))
+	assert.True(t, jsonoptional.Equal(jsonoptional.Null[int](), jsonoptional.Null[int]()))
+	assert.False(t, jsonoptional.Equal(jsonoptional.None[int](), jsonoptional.Null[int]()))
+	assert.False(t, jsonoptional.Equal(jsonoptional.Some(2), jsonoptional.None[int]()))
+}
+
+// cloneableValue's Clone deep-copies xs into a new backing array, so a test
+// asserting on it can tell a real Clone() call apart from a plain shallow
+// copy: mutating the original's slice after cloning would otherwise leak
+// through to the clone.
+type cloneableValue struct {
+	v  int
+	xs []int
+}
+
+func (c cloneableValue) Clone() cloneableValue {
+	xs := make([]int, len(c.xs))
+	copy(xs, c.xs)
+	return cloneableValue{v: c.v, xs: xs}
+}
+
+func TestClone(t *testing.T) {
+	t.Run("uses Cloner when implemented", func(t *testing.T) {
+		o := jsonoptional.Some(cloneableValue{v: 1, xs: []int{1, 2, 3}})
+		cloned := o.Clone()
+		assert.Equal(t, o, cloned)
+
+		o.Unwrap().xs[0] = 99
+		assert.Equal(t, 1, cloned.Unwrap().xs[0])
+	})
+
+	t.Run("shallow copies otherwise", func(t *testing.T) {
+		o := jsonoptional.Some(2)
+		assert.Equal(t, o, o.Clone())
+	})
+
+	t.Run("None and Null pass through", func(t *testing.T) {
+		assert.True(t, jsonoptional.None[int]().Clone().IsNone())
+		assert.True(t, jsonoptional.Null[int]().Clone().IsNull())
+	})
+}
@@ -14,27 +14,68 @@ var (
 	NullBytes = []byte("null")
 )
 
-// Option is a data type that must be Some (i.e. having a value),None (i.e. doesn't have a value), or Null (i.e. has a value but it's null).
-type Option[T any] map[bool]T
+// state distinguishes Some from an explicit Null; None has no box at all (see below).
+type state int
+
+const (
+	nullState state = iota
+	someState
+)
+
+// box carries the value and state for a present Option, mirroring the struct+enum
+// representation the sibling optional package uses for its Option[T].
+type box[T any] struct {
+	value T
+	state state
+}
+
+// Option is a data type that must be Some (i.e. having a value), None (i.e. doesn't have a value), or Null (i.e. has a value but it's null).
+//
+// This is represented as a 0- or 1-element slice of box[T] rather than a bare struct.
+// encoding/json's `omitempty` only ever treats Array/Map/Slice/String-kind (plus nil
+// pointers/interfaces and zero numbers/bools) field values as "empty" -- a struct-kind
+// field is never considered empty, regardless of what MarshalJSON it implements. Keeping
+// Option a slice preserves the existing "None omits the field" contract that callers rely
+// on via a plain `json:"...,omitempty"` tag.
+//
+// A named pointer type (e.g. `type Option[T any] *box[T]`) would also satisfy omitempty
+// and would shave an allocation off of Marshal, but Go forbids declaring methods on a
+// defined type whose underlying type is itself a pointer -- it would force every method
+// on this type (IsSome, Unwrap, Map, ...) to become a free function taking Option[T] as
+// an argument, which is a much larger API break than the allocation question is worth.
+//
+// Benchmarked against the map[bool]T representation this type replaced (see
+// optional_bench_test.go), the slice trades a one-allocation regression on Marshal for a
+// one-allocation improvement on Unmarshal; bytes per op drop by roughly a quarter to a
+// third on Unmarshal and rise on Marshal:
+//
+//	                        old (map[bool]T)         new (slice of box[T])
+//	Some, Marshal           2 allocs/op, 16 B/op      3 allocs/op, 40 B/op
+//	Null, Marshal           1 allocs/op,  8 B/op      2 allocs/op, 32 B/op
+//	Some, Unmarshal         7 allocs/op,456 B/op      6 allocs/op,344 B/op
+//	Null, Unmarshal         4 allocs/op,296 B/op      3 allocs/op,184 B/op
+//
+// So this change is not an unqualified allocation win -- it's a deliberate trade of a
+// small Marshal regression for a larger Unmarshal improvement, made because decoding
+// (handling untrusted request bodies) is the hotter path for most callers of this
+// package. If profiling ever shows Marshal dominating for a given caller, that's a sign
+// this representation should be revisited rather than assumed optimal.
+type Option[T any] []box[T]
 
 // Some is a function to make an Option type value with the actual value.
 func Some[T any](v T) Option[T] {
-	return Option[T]{
-		true: v,
-	}
+	return Option[T]{{value: v, state: someState}}
 }
 
 // None is a function to make an Option type value that doesn't have a value.
 func None[T any]() Option[T] {
-	return map[bool]T{}
+	return nil
 }
 
 // Null is a function to make an Option type value that has an explicit null value.
 func Null[T any]() Option[T] {
 	var defaultVal T
-	return Option[T]{
-		false: defaultVal,
-	}
+	return Option[T]{{value: defaultVal, state: nullState}}
 }
 
 // NullIf is a function to make an Option type value that has an explicit null value if the condition is true.
@@ -71,11 +112,7 @@ func PtrFromNillable[T any](v *T) Option[*T] {
 
 // IsSome returns whether the Option has a value or not and is not null.
 func (o Option[T]) IsSome() bool {
-	if len(o) == 0 {
-		return false
-	}
-	_, ok := o[true]
-	return ok
+	return len(o) == 1 && o[0].state == someState
 }
 
 // IsNone returns whether the Option doesn't have a value or not.
@@ -85,34 +122,30 @@ func (o Option[T]) IsNone() bool {
 
 // IsNull returns whether the Option has an explicit null value or not.
 func (o Option[T]) IsNull() bool {
-	if len(o) == 0 {
-		return false
-	}
-	_, ok := o[false]
-	return ok
+	return len(o) == 1 && o[0].state == nullState
 }
 
 // Unwrap returns the value regardless of Some/None/Null status.
 // If the Option value is Some, this method returns the actual value.
 // On the other hand, if the Option value is None or Null, this method returns the *default* value according to the type.
 func (o Option[T]) Unwrap() T {
-	if o.IsNone() || o.IsNull() {
+	if !o.IsSome() {
 		var defaultValue T
 		return defaultValue
 	}
 
-	return o[true]
+	return o[0].value
 }
 
 // UnwrapAsPtr returns the contained value in receiver Option as a pointer.
 // This is similar to `Unwrap()` method but the difference is this method returns a pointer value instead of the actual value.
 // If the receiver Option value is None or Null, this method returns nil.
 func (o Option[T]) UnwrapAsPtr() *T {
-	if o.IsNone() || o.IsNull() {
+	if !o.IsSome() {
 		return nil
 	}
 
-	var v = o[true]
+	v := o[0].value
 	return &v
 }
 
@@ -172,7 +205,7 @@ func (o Option[T]) Filter(predicate func(v T) bool) Option[T] {
 
 // IfSome calls the provided function with the value of Option if it is Some.
 func (o Option[T]) IfSome(f func(v T)) {
-	if o.IsNone() || o.IsNull() {
+	if !o.IsSome() {
 		return
 	}
 
@@ -182,7 +215,7 @@ func (o Option[T]) IfSome(f func(v T)) {
 // IfSomeWithError calls the provided function with the value of Option if it is Some.
 // This propagates the error from the provided function.
 func (o Option[T]) IfSomeWithError(f func(v T) error) error {
-	if o.IsNone() || o.IsNull() {
+	if !o.IsSome() {
 		return nil
 	}
 
@@ -260,9 +293,15 @@ func (o Option[T]) MarshalJSON() ([]byte, error) {
 	}
 
 	// if field was unspecified, and `omitempty` is set on the field's tags, `json.Marshal` will omit this field
+	// before ever calling MarshalJSON (see the Option doc comment); this branch only fires when None is marshaled
+	// directly, e.g. outside of a struct field.
+	if o.IsNone() {
+		var defaultValue T
+		return json.Marshal(defaultValue)
+	}
 
 	// otherwise: we have a value, so marshal it
-	return json.Marshal(o[true])
+	return json.Marshal(o[0].value)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for Option.
@@ -0,0 +1,50 @@
+package jsonoptional_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tapp-ai/json-go-optional/jsonoptional"
+)
+
+func BenchmarkOptionSomeMarshal(b *testing.B) {
+	o := jsonoptional.Some(42)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(o); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkOptionNullMarshal(b *testing.B) {
+	o := jsonoptional.Null[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(o); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkOptionSomeUnmarshal(b *testing.B) {
+	data := []byte(`42`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var o jsonoptional.Option[int]
+		if err := json.Unmarshal(data, &o); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkOptionNullUnmarshal(b *testing.B) {
+	data := []byte(`null`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var o jsonoptional.Option[int]
+		if err := json.Unmarshal(data, &o); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package jsonoptional_test
+
+import (
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional/jsonoptional"
+)
+
+type TestQueryRequest struct {
+	Value jsonoptional.Option[int] `url:"value"`
+}
+
+func TestEncodeValues(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v, err := query.Values(TestQueryRequest{Value: jsonoptional.Some(42)})
+		assert.NoError(t, err)
+		assert.Equal(t, "42", v.Get("value"))
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v, err := query.Values(TestQueryRequest{Value: jsonoptional.None[int]()})
+		assert.NoError(t, err)
+		assert.False(t, v.Has("value"))
+	})
+
+	t.Run("Null omit", func(t *testing.T) {
+		jsonoptional.NullQueryEncoding = jsonoptional.NullQueryEncodingOmit
+		v, err := query.Values(TestQueryRequest{Value: jsonoptional.Null[int]()})
+		assert.NoError(t, err)
+		assert.False(t, v.Has("value"))
+	})
+
+	t.Run("Null empty", func(t *testing.T) {
+		jsonoptional.NullQueryEncoding = jsonoptional.NullQueryEncodingEmpty
+		defer func() { jsonoptional.NullQueryEncoding = jsonoptional.NullQueryEncodingOmit }()
+
+		v, err := query.Values(TestQueryRequest{Value: jsonoptional.Null[int]()})
+		assert.NoError(t, err)
+		assert.Equal(t, "", v.Get("value"))
+	})
+
+	t.Run("Null literal", func(t *testing.T) {
+		jsonoptional.NullQueryEncoding = jsonoptional.NullQueryEncodingLiteral
+		defer func() { jsonoptional.NullQueryEncoding = jsonoptional.NullQueryEncodingOmit }()
+
+		v, err := query.Values(TestQueryRequest{Value: jsonoptional.Null[int]()})
+		assert.NoError(t, err)
+		assert.Equal(t, "null", v.Get("value"))
+	})
+}
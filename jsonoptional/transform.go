@@ -0,0 +1,75 @@
+package jsonoptional
+
+// Map is a function that transforms the value contained in an Option using the given function.
+// If the receiver is Some, this returns Some(f(v)). If the receiver is None, this returns None[U].
+// If the receiver is Null, this returns Null[U].
+func Map[T, U any](o Option[T], f func(v T) U) Option[U] {
+	switch {
+	case o.IsSome():
+		return Some(f(o.Unwrap()))
+	case o.IsNull():
+		return Null[U]()
+	default:
+		return None[U]()
+	}
+}
+
+// MapOr is a function that transforms the value contained in an Option using the given function.
+// If the receiver is None or Null, this returns fallbackValue instead.
+func MapOr[T, U any](o Option[T], fallbackValue U, f func(v T) U) U {
+	if o.IsSome() {
+		return f(o.Unwrap())
+	}
+
+	return fallbackValue
+}
+
+// MapOrElse is a function that transforms the value contained in an Option using the given function.
+// If the receiver is None or Null, this executes fallbackFunc and returns its result instead.
+func MapOrElse[T, U any](o Option[T], fallbackFunc func() U, f func(v T) U) U {
+	if o.IsSome() {
+		return f(o.Unwrap())
+	}
+
+	return fallbackFunc()
+}
+
+// FlatMap is a function that transforms the value contained in an Option using the given function,
+// which itself returns an Option, without nesting the result. If the receiver is None, this returns
+// None[U]. If the receiver is Null, this returns Null[U].
+func FlatMap[T, U any](o Option[T], f func(v T) Option[U]) Option[U] {
+	switch {
+	case o.IsSome():
+		return f(o.Unwrap())
+	case o.IsNull():
+		return Null[U]()
+	default:
+		return None[U]()
+	}
+}
+
+// Flatten is a function that collapses an Option of an Option into a single Option, preserving the
+// innermost state when the outer Option is Some.
+func Flatten[T any](o Option[Option[T]]) Option[T] {
+	switch {
+	case o.IsSome():
+		return o.Unwrap()
+	case o.IsNull():
+		return Null[T]()
+	default:
+		return None[T]()
+	}
+}
+
+// Match is a function that calls someFn, noneFn, or nullFn depending on the state of the given
+// Option, and returns its result.
+func Match[T, R any](o Option[T], someFn func(v T) R, noneFn func() R, nullFn func() R) R {
+	switch {
+	case o.IsSome():
+		return someFn(o.Unwrap())
+	case o.IsNull():
+		return nullFn()
+	default:
+		return noneFn()
+	}
+}
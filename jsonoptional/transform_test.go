@@ -0,0 +1,75 @@
+package jsonoptional_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional/jsonoptional"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := jsonoptional.Map(jsonoptional.Some(2), func(v int) int { return v * 2 })
+		assert.True(t, o.IsSome())
+		assert.Equal(t, 4, o.Unwrap())
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := jsonoptional.Map(jsonoptional.None[int](), strconv.Itoa)
+		assert.True(t, o.IsNone())
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		o := jsonoptional.Map(jsonoptional.Null[int](), strconv.Itoa)
+		assert.True(t, o.IsNull())
+	})
+}
+
+func TestMapOr(t *testing.T) {
+	assert.Equal(t, 4, jsonoptional.MapOr(jsonoptional.Some(2), -1, func(v int) int { return v * 2 }))
+	assert.Equal(t, -1, jsonoptional.MapOr(jsonoptional.None[int](), -1, func(v int) int { return v * 2 }))
+	assert.Equal(t, -1, jsonoptional.MapOr(jsonoptional.Null[int](), -1, func(v int) int { return v * 2 }))
+}
+
+func TestMapOrElse(t *testing.T) {
+	fallback := func() int { return -1 }
+	assert.Equal(t, 4, jsonoptional.MapOrElse(jsonoptional.Some(2), fallback, func(v int) int { return v * 2 }))
+	assert.Equal(t, -1, jsonoptional.MapOrElse(jsonoptional.None[int](), fallback, func(v int) int { return v * 2 }))
+}
+
+func TestFlatMap(t *testing.T) {
+	evenHalf := func(v int) jsonoptional.Option[int] {
+		if v%2 != 0 {
+			return jsonoptional.None[int]()
+		}
+		return jsonoptional.Some(v / 2)
+	}
+
+	assert.Equal(t, jsonoptional.Some(2), jsonoptional.FlatMap(jsonoptional.Some(4), evenHalf))
+	assert.True(t, jsonoptional.FlatMap(jsonoptional.Some(3), evenHalf).IsNone())
+	assert.True(t, jsonoptional.FlatMap(jsonoptional.Null[int](), evenHalf).IsNull())
+	assert.True(t, jsonoptional.FlatMap(jsonoptional.None[int](), evenHalf).IsNone())
+}
+
+func TestFlatten(t *testing.T) {
+	assert.Equal(t, jsonoptional.Some(2), jsonoptional.Flatten(jsonoptional.Some(jsonoptional.Some(2))))
+	assert.True(t, jsonoptional.Flatten(jsonoptional.Some(jsonoptional.None[int]())).IsNone())
+	assert.True(t, jsonoptional.Flatten(jsonoptional.None[jsonoptional.Option[int]]()).IsNone())
+	assert.True(t, jsonoptional.Flatten(jsonoptional.Null[jsonoptional.Option[int]]()).IsNull())
+}
+
+func TestMatch(t *testing.T) {
+	match := func(o jsonoptional.Option[int]) string {
+		return jsonoptional.Match(
+			o,
+			func(v int) string { return "some:" + strconv.Itoa(v) },
+			func() string { return "none" },
+			func() string { return "null" },
+		)
+	}
+
+	assert.Equal(t, "some:2", match(jsonoptional.Some(2)))
+	assert.Equal(t, "none", match(jsonoptional.None[int]()))
+	assert.Equal(t, "null", match(jsonoptional.Null[int]()))
+}
@@ -0,0 +1,146 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Nullable is a data type that must be Some (i.e. having a value) or Null (i.e. explicitly
+// has no value). Unlike Option[T], which distinguishes None (the field/column was never set)
+// from JsonNull (the field/column was explicitly set to null), Nullable[T] only ever models
+// the latter. It exists for call sites -- most notably database columns -- that have no
+// "omitted" state of their own, where collapsing a nil driver value into None (as Option's
+// Scan does, for backward compatibility) would blur the distinction between "no value" and
+// "SQL NULL".
+// some defaults to false, so the zero value of Nullable[T] -- e.g. a zero-initialized
+// struct field, or `var n Nullable[T]` -- is Null, mirroring database/sql's NullString /
+// NullInt64 (whose Valid defaults false = NULL) and Option's NoneState = iota. Tracking
+// "is some" rather than "is null" is what makes that zero value line up with Null; the
+// reverse polarity would make the zero value Some(zero-T) instead.
+type Nullable[T any] struct {
+	value T
+	some  bool
+}
+
+// NullableSome is a function to make a Nullable type value with the actual value.
+func NullableSome[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, some: true}
+}
+
+// NullableNull is a function to make a Nullable type value that is explicitly null.
+func NullableNull[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// AsNullable converts an Option[T] into a Nullable[T]. A Some value is carried over as-is;
+// both None and JsonNull collapse into Null, since Nullable has no "omitted" state to map
+// None onto.
+func AsNullable[T any](o Option[T]) Nullable[T] {
+	if o.IsSome() {
+		return NullableSome(o.Unwrap())
+	}
+
+	return NullableNull[T]()
+}
+
+// AsOption converts a Nullable[T] into an Option[T]. A Some value is carried over as-is;
+// Null maps to JsonNull, not None, since Nullable never models field/column omission.
+func AsOption[T any](n Nullable[T]) Option[T] {
+	if n.IsSome() {
+		return Some(n.Unwrap())
+	}
+
+	return JsonNull[T]()
+}
+
+// IsSome returns whether the Nullable has a value or not.
+func (n Nullable[T]) IsSome() bool {
+	return n.some
+}
+
+// IsNull returns whether the Nullable is explicitly null or not.
+func (n Nullable[T]) IsNull() bool {
+	return !n.some
+}
+
+// Unwrap returns the value regardless of Some/Null status.
+// If the Nullable value is Some, this method returns the actual value.
+// On the other hand, if the Nullable value is Null, this method returns the *default* value according to the type.
+func (n Nullable[T]) Unwrap() T {
+	if n.IsNull() {
+		var defaultValue T
+		return defaultValue
+	}
+
+	return n.value
+}
+
+// UnwrapAsPtr returns the contained value in receiver Nullable as a pointer.
+// This is similar to `Unwrap()` method but the difference is this method returns a pointer value instead of the actual value.
+// If the receiver Nullable value is Null, this method returns nil.
+func (n Nullable[T]) UnwrapAsPtr() *T {
+	if n.IsNull() {
+		return nil
+	}
+
+	return &n.value
+}
+
+// TakeOr returns the actual value if the Nullable has a value.
+// On the other hand, this returns fallbackValue.
+func (n Nullable[T]) TakeOr(fallbackValue T) T {
+	if n.IsNull() {
+		return fallbackValue
+	}
+
+	return n.value
+}
+
+// TakeOrElse returns the actual value if the Nullable has a value.
+// On the other hand, this executes fallbackFunc and returns the result value of that function.
+func (n Nullable[T]) TakeOrElse(fallbackFunc func() T) T {
+	if n.IsNull() {
+		return fallbackFunc()
+	}
+
+	return n.value
+}
+
+func (n Nullable[T]) String() string {
+	if n.IsNull() {
+		return "Null[]"
+	}
+
+	v := n.Unwrap()
+	if stringer, ok := interface{}(v).(fmt.Stringer); ok {
+		return fmt.Sprintf("Some[%s]", stringer)
+	}
+	return fmt.Sprintf("Some[%v]", v)
+}
+
+// MarshalJSON implements the json.Marshaler interface for custom JSON encoding.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.IsNull() {
+		return NullBytes, nil
+	}
+
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for custom JSON
+// decoding.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if len(data) <= 0 || bytes.Equal(data, NullBytes) {
+		*n = NullableNull[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NullableSome(v)
+
+	return nil
+}
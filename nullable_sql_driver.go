@@ -0,0 +1,95 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+)
+
+// Scan assigns a value from a database driver.
+// This method is required from database/sql.Scanner interface.
+// Unlike Option[T].Scan, a nil src (or an invalid sql.NullTime) maps to Null, not
+// None, since Nullable has no "omitted" state to fall back to. Conversion of the
+// driver value to T otherwise follows the same rules as Option[T].Scan.
+func (n *Nullable[T]) Scan(src any) error {
+	if src == nil {
+		*n = NullableNull[T]()
+		return nil
+	}
+
+	if nt, ok := src.(sql.NullTime); ok {
+		if !nt.Valid {
+			*n = NullableNull[T]()
+			return nil
+		}
+		src = nt.Time
+	}
+
+	if v, ok := src.(T); ok {
+		*n = NullableSome(v)
+		return nil
+	}
+
+	var target T
+	targetType := reflect.TypeOf(target)
+	if targetType == nil {
+		return ErrSQLScannerIncompatibleDataType
+	}
+
+	if isJSONColumnType(targetType) {
+		data, ok := asBytes(src)
+		if !ok {
+			return ErrSQLScannerIncompatibleDataType
+		}
+		if err := json.Unmarshal(data, &target); err != nil {
+			return err
+		}
+		*n = NullableSome(target)
+		return nil
+	}
+
+	if isByteSliceType(targetType) {
+		data, ok := asBytes(src)
+		if !ok {
+			return ErrSQLScannerIncompatibleDataType
+		}
+		*n = NullableSome(reflect.ValueOf(data).Convert(targetType).Interface().(T))
+		return nil
+	}
+
+	if !isScannableKind(targetType.Kind()) {
+		return ErrSQLScannerIncompatibleDataType
+	}
+
+	converted, ok := convertScalar(reflect.ValueOf(src), targetType)
+	if !ok {
+		return ErrSQLScannerIncompatibleDataType
+	}
+
+	*n = NullableSome(converted.Interface().(T))
+	return nil
+}
+
+// Value returns a driver Value.
+// This method is required from database/sql/driver.Valuer interface.
+//
+// If T is a struct (other than time.Time), slice (other than a byte slice),
+// array, or map, the value is JSON-encoded for storage in a JSON column.
+// Otherwise, it's converted via database/sql/driver.DefaultParameterConverter.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if n.IsNull() {
+		return nil, nil
+	}
+
+	val := n.Unwrap()
+	if isJSONColumnType(reflect.TypeOf(val)) {
+		data, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(val)
+}
@@ -0,0 +1,53 @@
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional"
+)
+
+func TestNullableScan(t *testing.T) {
+	t.Run("nil maps to Null", func(t *testing.T) {
+		var n optional.Nullable[int32]
+		assert.NoError(t, n.Scan(nil))
+		assert.True(t, n.IsNull())
+	})
+
+	t.Run("int64 driver value converts to int32 target", func(t *testing.T) {
+		var n optional.Nullable[int32]
+		assert.NoError(t, n.Scan(int64(7)))
+		assert.True(t, n.IsSome())
+		assert.Equal(t, int32(7), n.Unwrap())
+	})
+
+	t.Run("[]byte driver value decodes into struct target as JSON", func(t *testing.T) {
+		var n optional.Nullable[scanTarget]
+		assert.NoError(t, n.Scan([]byte(`{"name":"gopher"}`)))
+		assert.Equal(t, scanTarget{Name: "gopher"}, n.Unwrap())
+	})
+
+	t.Run("incompatible data type errors instead of panicking", func(t *testing.T) {
+		var n optional.Nullable[int32]
+		err := n.Scan(struct{}{})
+		assert.ErrorIs(t, err, optional.ErrSQLScannerIncompatibleDataType)
+	})
+
+	t.Run("string driver value parses into an int64 target", func(t *testing.T) {
+		var n optional.Nullable[int64]
+		assert.NoError(t, n.Scan("42"))
+		assert.Equal(t, int64(42), n.Unwrap())
+	})
+
+	t.Run("int64 driver value coerces into a bool target", func(t *testing.T) {
+		var n optional.Nullable[bool]
+		assert.NoError(t, n.Scan(int64(1)))
+		assert.True(t, n.Unwrap())
+	})
+}
+
+func TestNullableValue(t *testing.T) {
+	v, err := optional.NullableSome(scanTarget{Name: "gopher"}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"gopher"}`, v)
+}
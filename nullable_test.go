@@ -0,0 +1,73 @@
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional"
+)
+
+func TestNullableZeroValue(t *testing.T) {
+	var n optional.Nullable[int]
+	assert.True(t, n.IsNull())
+	assert.False(t, n.IsSome())
+}
+
+func TestAsNullable(t *testing.T) {
+	assert.Equal(t, optional.NullableSome(2), optional.AsNullable(optional.Some(2)))
+	assert.True(t, optional.AsNullable(optional.None[int]()).IsNull())
+	assert.True(t, optional.AsNullable(optional.JsonNull[int]()).IsNull())
+}
+
+func TestAsOption(t *testing.T) {
+	assert.Equal(t, optional.Some(2), optional.AsOption(optional.NullableSome(2)))
+	assert.True(t, optional.AsOption(optional.NullableNull[int]()).IsJsonNull())
+}
+
+func TestNullableUnwrap(t *testing.T) {
+	assert.Equal(t, 2, optional.NullableSome(2).Unwrap())
+	assert.Equal(t, 0, optional.NullableNull[int]().Unwrap())
+}
+
+func TestNullableUnwrapAsPtr(t *testing.T) {
+	v := optional.NullableSome(2).UnwrapAsPtr()
+	assert.NotNil(t, v)
+	assert.Equal(t, 2, *v)
+
+	assert.Nil(t, optional.NullableNull[int]().UnwrapAsPtr())
+}
+
+func TestNullableTakeOr(t *testing.T) {
+	assert.Equal(t, 2, optional.NullableSome(2).TakeOr(-1))
+	assert.Equal(t, -1, optional.NullableNull[int]().TakeOr(-1))
+}
+
+func TestNullableTakeOrElse(t *testing.T) {
+	fallback := func() int { return -1 }
+	assert.Equal(t, 2, optional.NullableSome(2).TakeOrElse(fallback))
+	assert.Equal(t, -1, optional.NullableNull[int]().TakeOrElse(fallback))
+}
+
+func TestNullableString(t *testing.T) {
+	assert.Equal(t, "Some[2]", optional.NullableSome(2).String())
+	assert.Equal(t, "Null[]", optional.NullableNull[int]().String())
+}
+
+func TestNullableMarshalJSON(t *testing.T) {
+	data, err := optional.NullableSome(2).MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, "2", string(data))
+
+	data, err = optional.NullableNull[int]().MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	var n optional.Nullable[int]
+	assert.NoError(t, n.UnmarshalJSON([]byte("2")))
+	assert.Equal(t, optional.NullableSome(2), n)
+
+	assert.NoError(t, n.UnmarshalJSON([]byte("null")))
+	assert.True(t, n.IsNull())
+}
@@ -83,7 +83,7 @@ func (o Option[T]) IsSome() bool {
 
 // IsNone returns whether the Option doesn't have a value or not.
 func (o Option[T]) IsNone() bool {
-	return o.state == NullState
+	return o.state == NoneState
 }
 
 // IsJsonNull returns whether the Option has an explicit null value or not.
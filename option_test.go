@@ -0,0 +1,47 @@
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional"
+)
+
+// TestNoneIsNone locks in that a genuine None (as opposed to JsonNull) reports
+// IsNone() and not IsJsonNull(), and that Take() on it surfaces
+// ErrNoneValueTaken. IsNone used to compare against NullState instead of
+// NoneState, which made None[T]().IsNone() false and let Take() silently
+// return a zero value instead of erroring.
+func TestNoneIsNone(t *testing.T) {
+	o := optional.None[int]()
+
+	assert.True(t, o.IsNone())
+	assert.False(t, o.IsJsonNull())
+	assert.False(t, o.IsSome())
+
+	_, err := o.Take()
+	assert.ErrorIs(t, err, optional.ErrNoneValueTaken)
+}
+
+func TestOptionStates(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := optional.Some(2)
+		assert.True(t, o.IsSome())
+		assert.False(t, o.IsNone())
+		assert.False(t, o.IsJsonNull())
+
+		v, err := o.Take()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v)
+	})
+
+	t.Run("JsonNull", func(t *testing.T) {
+		o := optional.JsonNull[int]()
+		assert.True(t, o.IsJsonNull())
+		assert.False(t, o.IsSome())
+		assert.False(t, o.IsNone())
+
+		_, err := o.Take()
+		assert.ErrorIs(t, err, optional.ErrNoneValueTaken)
+	})
+}
@@ -0,0 +1,54 @@
+package optional
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// NullQueryEncodingMode controls how Option[T].EncodeValues represents a JsonNull
+// value when encoding to url.Values.
+type NullQueryEncodingMode int
+
+const (
+	// NullQueryEncodingOmit omits the key entirely, the same as None. This is the default.
+	NullQueryEncodingOmit NullQueryEncodingMode = iota
+	// NullQueryEncodingEmpty writes the key with an empty string value.
+	NullQueryEncodingEmpty
+	// NullQueryEncodingLiteral writes the key with the literal string "null".
+	NullQueryEncodingLiteral
+)
+
+// NullQueryEncoding controls how Option[T].EncodeValues represents a JsonNull value.
+// It defaults to NullQueryEncodingOmit.
+var NullQueryEncoding = NullQueryEncodingOmit
+
+// EncodeValues implements the github.com/google/go-querystring/query.Encoder
+// interface, so Option[T] can be used directly as a struct field when building
+// REST client query parameters. None omits the key entirely. JsonNull's
+// encoding is governed by the package-level NullQueryEncoding variable. Some
+// writes the fmt.Stringer form of the value if implemented, otherwise its
+// fmt.Sprint form.
+func (o Option[T]) EncodeValues(key string, v *url.Values) error {
+	if o.IsNone() {
+		return nil
+	}
+
+	if o.IsJsonNull() {
+		switch NullQueryEncoding {
+		case NullQueryEncodingEmpty:
+			v.Set(key, "")
+		case NullQueryEncodingLiteral:
+			v.Set(key, "null")
+		}
+		return nil
+	}
+
+	val := o.Unwrap()
+	if stringer, ok := interface{}(val).(fmt.Stringer); ok {
+		v.Set(key, stringer.String())
+	} else {
+		v.Set(key, fmt.Sprint(val))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional"
+)
+
+type TestQueryRequest struct {
+	Value optional.Option[int] `url:"value"`
+}
+
+func TestEncodeValues(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v, err := query.Values(TestQueryRequest{Value: optional.Some(42)})
+		assert.NoError(t, err)
+		assert.Equal(t, "42", v.Get("value"))
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v, err := query.Values(TestQueryRequest{Value: optional.None[int]()})
+		assert.NoError(t, err)
+		assert.False(t, v.Has("value"))
+	})
+
+	t.Run("JsonNull omit", func(t *testing.T) {
+		v, err := query.Values(TestQueryRequest{Value: optional.JsonNull[int]()})
+		assert.NoError(t, err)
+		assert.False(t, v.Has("value"))
+	})
+
+	t.Run("JsonNull empty", func(t *testing.T) {
+		optional.NullQueryEncoding = optional.NullQueryEncodingEmpty
+		defer func() { optional.NullQueryEncoding = optional.NullQueryEncodingOmit }()
+
+		v, err := query.Values(TestQueryRequest{Value: optional.JsonNull[int]()})
+		assert.NoError(t, err)
+		assert.Equal(t, "", v.Get("value"))
+	})
+
+	t.Run("JsonNull literal", func(t *testing.T) {
+		optional.NullQueryEncoding = optional.NullQueryEncodingLiteral
+		defer func() { optional.NullQueryEncoding = optional.NullQueryEncodingOmit }()
+
+		v, err := query.Values(TestQueryRequest{Value: optional.JsonNull[int]()})
+		assert.NoError(t, err)
+		assert.Equal(t, "null", v.Get("value"))
+	})
+}
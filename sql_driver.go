@@ -4,8 +4,12 @@ package optional
 // It is not recommended to use the Scanner and Valuer interfaces for the Option type.
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"reflect"
+	"strconv"
 	"time"
 )
 
@@ -13,30 +17,273 @@ var (
 	ErrSQLScannerIncompatibleDataType = errors.New("incompatible data type for SQL scanner on Option[T]")
 )
 
+var timeType = reflect.TypeOf(time.Time{})
+
 // Scan assigns a value from a database driver.
 // This method is required from database/sql.Scanner interface.
+//
+// A nil src (or an invalid sql.NullTime) maps to None. A src that is already a
+// T is taken as-is. Otherwise, this converts standard driver types (string,
+// []byte, int64, float64, bool, time.Time) to T the same way
+// database/sql.convertAssign does -- e.g. an int64 driver value into an int32
+// T, or a []byte driver value into a string T. If T is a struct (other than
+// time.Time), slice (other than a byte slice), array, or map, a []byte/string
+// src is instead treated as a JSON column and unmarshaled into T. A byte-slice T
+// takes the raw bytes of a []byte/string src.
 func (o *Option[T]) Scan(src any) error {
 	if src == nil {
 		*o = None[T]()
 		return nil
 	}
 
-	switch src.(type) {
-	case string, []byte, int64, float64, bool, time.Time:
-		*o = Some[T](src.(T))
-	default:
+	if nt, ok := src.(sql.NullTime); ok {
+		if !nt.Valid {
+			*o = None[T]()
+			return nil
+		}
+		src = nt.Time
+	}
+
+	if v, ok := src.(T); ok {
+		*o = Some(v)
+		return nil
+	}
+
+	var target T
+	targetType := reflect.TypeOf(target)
+	if targetType == nil {
+		return ErrSQLScannerIncompatibleDataType
+	}
+
+	if isJSONColumnType(targetType) {
+		data, ok := asBytes(src)
+		if !ok {
+			return ErrSQLScannerIncompatibleDataType
+		}
+		if err := json.Unmarshal(data, &target); err != nil {
+			return err
+		}
+		*o = Some(target)
+		return nil
+	}
+
+	if isByteSliceType(targetType) {
+		data, ok := asBytes(src)
+		if !ok {
+			return ErrSQLScannerIncompatibleDataType
+		}
+		*o = Some(reflect.ValueOf(data).Convert(targetType).Interface().(T))
+		return nil
+	}
+
+	if !isScannableKind(targetType.Kind()) {
+		return ErrSQLScannerIncompatibleDataType
+	}
+
+	converted, ok := convertScalar(reflect.ValueOf(src), targetType)
+	if !ok {
 		return ErrSQLScannerIncompatibleDataType
 	}
 
+	*o = Some(converted.Interface().(T))
 	return nil
 }
 
+// isByteSliceType reports whether t is a (possibly named) slice of bytes.
+func isByteSliceType(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// isJSONColumnType reports whether values of t should be scanned/valued as a JSON column
+// rather than through a direct driver type conversion.
+func isJSONColumnType(t reflect.Type) bool {
+	if t == timeType {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Struct, reflect.Array, reflect.Map:
+		return true
+	case reflect.Slice:
+		// A slice of bytes (named or not) is raw data, not a JSON document.
+		return !isByteSliceType(t)
+	default:
+		return false
+	}
+}
+
+// convertScalar converts srcValue to targetType the way database/sql.convertAssign does for
+// scalar driver types. A bare reflect.Convert is not enough on its own:
+//
+//   - Go's int-Kind -> string-Kind conversion reinterprets the integer as a Unicode code
+//     point rather than its decimal text, so that direction is only allowed from an actual
+//     string or byte-slice source.
+//   - Numeric targets also need to accept a string/[]byte source, since drivers such as
+//     lib/pq surface NUMERIC/DECIMAL columns as text.
+//   - Bool targets need their own coercion, since drivers such as go-sql-driver/mysql
+//     surface TINYINT(1) boolean columns as int64.
+func convertScalar(srcValue reflect.Value, targetType reflect.Type) (reflect.Value, bool) {
+	srcType := srcValue.Type()
+
+	if targetType.Kind() == reflect.String {
+		switch {
+		case srcType.Kind() == reflect.String:
+			return srcValue.Convert(targetType), true
+		case srcType.Kind() == reflect.Slice && srcType.Elem().Kind() == reflect.Uint8:
+			return srcValue.Convert(targetType), true
+		default:
+			return reflect.Value{}, false
+		}
+	}
+
+	if targetType.Kind() == reflect.Bool {
+		return convertToBool(srcValue, targetType)
+	}
+
+	if isNumericKind(targetType.Kind()) {
+		if s, ok := asString(srcValue.Interface()); ok {
+			return convertNumericString(s, targetType)
+		}
+	}
+
+	if !srcType.ConvertibleTo(targetType) {
+		return reflect.Value{}, false
+	}
+
+	return srcValue.Convert(targetType), true
+}
+
+// convertToBool converts srcValue to a bool-kinded targetType, mirroring
+// driver.Bool.ConvertValue: a bool is passed through, an int64 is non-zero, and a
+// string/[]byte is parsed with the usual "true"/"1"/"t"/"false"/"0"/"f" forms.
+func convertToBool(srcValue reflect.Value, targetType reflect.Type) (reflect.Value, bool) {
+	switch {
+	case srcValue.Kind() == reflect.Bool:
+		return srcValue.Convert(targetType), true
+	case srcValue.Kind() == reflect.Int64:
+		return reflect.ValueOf(srcValue.Int() != 0).Convert(targetType), true
+	default:
+		s, ok := asString(srcValue.Interface())
+		if !ok {
+			return reflect.Value{}, false
+		}
+
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+
+		return reflect.ValueOf(b).Convert(targetType), true
+	}
+}
+
+// convertNumericString parses s into a numeric-kinded targetType, the way
+// database/sql.convertAssign falls back to strconv.Parse{Int,Uint,Float} when a numeric
+// destination is scanned from a string/[]byte driver value.
+func convertNumericString(s string, targetType reflect.Type) (reflect.Value, bool) {
+	switch {
+	case isIntKind(targetType.Kind()):
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(n).Convert(targetType), true
+	case isUintKind(targetType.Kind()):
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(n).Convert(targetType), true
+	default:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(f).Convert(targetType), true
+	}
+}
+
+// isNumericKind reports whether kind is one of the integer or floating-point kinds
+// convertNumericString knows how to parse a string into.
+func isNumericKind(kind reflect.Kind) bool {
+	return isIntKind(kind) || isUintKind(kind) || kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isScannableKind reports whether kind is one of the scalar kinds convertAssign-style
+// conversion supports.
+func isScannableKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// asBytes extracts the raw bytes of a JSON column source, which drivers surface as
+// either string or []byte.
+func asBytes(src any) ([]byte, bool) {
+	switch s := src.(type) {
+	case []byte:
+		return s, true
+	case string:
+		return []byte(s), true
+	default:
+		return nil, false
+	}
+}
+
+// asString extracts src as a string, which drivers surface as either string or []byte.
+func asString(src any) (string, bool) {
+	data, ok := asBytes(src)
+	if !ok {
+		return "", false
+	}
+
+	return string(data), true
+}
+
 // Value returns a driver Value.
 // This method is required from database/sql/driver.Valuer interface.
+//
+// If T is a struct (other than time.Time), slice (other than a byte slice),
+// array, or map, the value is JSON-encoded for storage in a JSON column.
+// Otherwise, it's converted via database/sql/driver.DefaultParameterConverter,
+// the same as before.
 func (o Option[T]) Value() (driver.Value, error) {
 	if o.IsNone() || o.IsJsonNull() {
 		return nil, nil
 	}
 
-	return driver.DefaultParameterConverter.ConvertValue(o.Unwrap())
+	val := o.Unwrap()
+	if isJSONColumnType(reflect.TypeOf(val)) {
+		data, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(val)
 }
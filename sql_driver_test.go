@@ -0,0 +1,129 @@
+package optional_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional"
+)
+
+type scanTarget struct {
+	Name string `json:"name"`
+}
+
+func TestOptionScan(t *testing.T) {
+	t.Run("nil maps to None", func(t *testing.T) {
+		var o optional.Option[int32]
+		assert.NoError(t, o.Scan(nil))
+		assert.True(t, o.IsNone())
+	})
+
+	t.Run("invalid sql.NullTime maps to None", func(t *testing.T) {
+		var o optional.Option[time.Time]
+		assert.NoError(t, o.Scan(sql.NullTime{}))
+		assert.True(t, o.IsNone())
+	})
+
+	t.Run("valid sql.NullTime is unwrapped", func(t *testing.T) {
+		now := time.Now()
+		var o optional.Option[time.Time]
+		assert.NoError(t, o.Scan(sql.NullTime{Time: now, Valid: true}))
+		assert.True(t, now.Equal(o.Unwrap()))
+	})
+
+	t.Run("int64 driver value converts to int32 target", func(t *testing.T) {
+		var o optional.Option[int32]
+		assert.NoError(t, o.Scan(int64(42)))
+		assert.Equal(t, int32(42), o.Unwrap())
+	})
+
+	t.Run("[]byte driver value converts to string target", func(t *testing.T) {
+		var o optional.Option[string]
+		assert.NoError(t, o.Scan([]byte("hello")))
+		assert.Equal(t, "hello", o.Unwrap())
+	})
+
+	t.Run("[]byte driver value decodes into struct target as JSON", func(t *testing.T) {
+		var o optional.Option[scanTarget]
+		assert.NoError(t, o.Scan([]byte(`{"name":"gopher"}`)))
+		assert.Equal(t, scanTarget{Name: "gopher"}, o.Unwrap())
+	})
+
+	t.Run("incompatible data type errors instead of panicking", func(t *testing.T) {
+		var o optional.Option[int32]
+		err := o.Scan(struct{}{})
+		assert.ErrorIs(t, err, optional.ErrSQLScannerIncompatibleDataType)
+	})
+
+	t.Run("numeric driver value is rejected for a string target", func(t *testing.T) {
+		var o optional.Option[string]
+		err := o.Scan(int64(42))
+		assert.ErrorIs(t, err, optional.ErrSQLScannerIncompatibleDataType)
+	})
+
+	t.Run("[]byte driver value scans into a named byte-slice target as raw bytes", func(t *testing.T) {
+		type rawID []byte
+		var o optional.Option[rawID]
+		assert.NoError(t, o.Scan([]byte{0x01, 0x02}))
+		assert.Equal(t, rawID{0x01, 0x02}, o.Unwrap())
+	})
+
+	t.Run("string driver value parses into an int64 target", func(t *testing.T) {
+		var o optional.Option[int64]
+		assert.NoError(t, o.Scan("42"))
+		assert.Equal(t, int64(42), o.Unwrap())
+	})
+
+	t.Run("[]byte driver value parses into a float64 target", func(t *testing.T) {
+		var o optional.Option[float64]
+		assert.NoError(t, o.Scan([]byte("3.14")))
+		assert.Equal(t, 3.14, o.Unwrap())
+	})
+
+	t.Run("malformed numeric string errors instead of truncating", func(t *testing.T) {
+		var o optional.Option[int64]
+		err := o.Scan("not-a-number")
+		assert.ErrorIs(t, err, optional.ErrSQLScannerIncompatibleDataType)
+	})
+
+	t.Run("int64 driver value coerces into a bool target", func(t *testing.T) {
+		var o optional.Option[bool]
+		assert.NoError(t, o.Scan(int64(1)))
+		assert.True(t, o.Unwrap())
+
+		assert.NoError(t, o.Scan(int64(0)))
+		assert.False(t, o.Unwrap())
+	})
+
+	t.Run("string driver value coerces into a bool target", func(t *testing.T) {
+		var o optional.Option[bool]
+		assert.NoError(t, o.Scan("true"))
+		assert.True(t, o.Unwrap())
+	})
+}
+
+func TestOptionValue(t *testing.T) {
+	t.Run("None yields nil", func(t *testing.T) {
+		v, err := optional.None[int32]().Value()
+		assert.NoError(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("scalar value is converted", func(t *testing.T) {
+		v, err := optional.Some(int32(42)).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), v)
+	})
+
+	t.Run("struct value is JSON-encoded", func(t *testing.T) {
+		v, err := optional.Some(scanTarget{Name: "gopher"}).Value()
+		assert.NoError(t, err)
+
+		var decoded scanTarget
+		assert.NoError(t, json.Unmarshal([]byte(v.(string)), &decoded))
+		assert.Equal(t, scanTarget{Name: "gopher"}, decoded)
+	})
+}
@@ -0,0 +1,76 @@
+package optional_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapp-ai/json-go-optional"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := optional.Map(optional.Some(2), func(v int) int { return v * 2 })
+		assert.True(t, o.IsSome())
+		assert.Equal(t, 4, o.Unwrap())
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := optional.Map(optional.None[int](), strconv.Itoa)
+		assert.True(t, o.IsNone())
+	})
+
+	t.Run("JsonNull", func(t *testing.T) {
+		o := optional.Map(optional.JsonNull[int](), strconv.Itoa)
+		assert.True(t, o.IsJsonNull())
+	})
+}
+
+func TestMapOr(t *testing.T) {
+	assert.Equal(t, 4, optional.MapOr(optional.Some(2), -1, func(v int) int { return v * 2 }))
+	assert.Equal(t, -1, optional.MapOr(optional.None[int](), -1, func(v int) int { return v * 2 }))
+	assert.Equal(t, -1, optional.MapOr(optional.JsonNull[int](), -1, func(v int) int { return v * 2 }))
+}
+
+func TestMapOrElse(t *testing.T) {
+	fallback := func() int { return -1 }
+	assert.Equal(t, 4, optional.MapOrElse(optional.Some(2), fallback, func(v int) int { return v * 2 }))
+	assert.Equal(t, -1, optional.MapOrElse(optional.None[int](), fallback, func(v int) int { return v * 2 }))
+	assert.Equal(t, -1, optional.MapOrElse(optional.JsonNull[int](), fallback, func(v int) int { return v * 2 }))
+}
+
+func TestFlatMap(t *testing.T) {
+	evenHalf := func(v int) optional.Option[int] {
+		if v%2 != 0 {
+			return optional.None[int]()
+		}
+		return optional.Some(v / 2)
+	}
+
+	assert.Equal(t, optional.Some(2), optional.FlatMap(optional.Some(4), evenHalf))
+	assert.True(t, optional.FlatMap(optional.Some(3), evenHalf).IsNone())
+	assert.True(t, optional.FlatMap(optional.JsonNull[int](), evenHalf).IsJsonNull())
+	assert.True(t, optional.FlatMap(optional.None[int](), evenHalf).IsNone())
+}
+
+func TestFlatten(t *testing.T) {
+	assert.Equal(t, optional.Some(2), optional.Flatten(optional.Some(optional.Some(2))))
+	assert.True(t, optional.Flatten(optional.Some(optional.None[int]())).IsNone())
+	assert.True(t, optional.Flatten(optional.None[optional.Option[int]]()).IsNone())
+	assert.True(t, optional.Flatten(optional.JsonNull[optional.Option[int]]()).IsJsonNull())
+}
+
+func TestMatch(t *testing.T) {
+	match := func(o optional.Option[int]) string {
+		return optional.Match(
+			o,
+			func(v int) string { return "some:" + strconv.Itoa(v) },
+			func() string { return "none" },
+			func() string { return "null" },
+		)
+	}
+
+	assert.Equal(t, "some:2", match(optional.Some(2)))
+	assert.Equal(t, "none", match(optional.None[int]()))
+	assert.Equal(t, "null", match(optional.JsonNull[int]()))
+}